@@ -2,14 +2,19 @@ package encoding
 
 import (
 	"crypto/ecdsa"
+	"encoding/asn1"
 	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
 )
 
 func EncodeS256PubKey(pubKey *ecdsa.PublicKey) ([]byte, error) {
-	publicKeyBytes := append(pubKey.X.Bytes(), pubKey.Y.Bytes()...)
+	publicKeyBytes := make([]byte, 64)
+	pubKey.X.FillBytes(publicKeyBytes[0:32])
+	pubKey.Y.FillBytes(publicKeyBytes[32:64])
 	return publicKeyBytes, nil
 }
 
@@ -42,3 +47,177 @@ func ComposeECDSASignature(r, s, recovery []byte) []byte {
 	sigBytes[64] = recovery[0]
 	return sigBytes
 }
+
+// asn1Signature is the ASN.1 structure of a DER-encoded ECDSA signature:
+// SEQUENCE { r INTEGER, s INTEGER }, as used by Bitcoin and OpenSSL.
+type asn1Signature struct {
+	R, S *big.Int
+}
+
+// EncodeECDSASignatureDER encodes r, s as a standard ASN.1 DER
+// SEQUENCE(INTEGER r, INTEGER s), the form used by Bitcoin and OpenSSL.
+func EncodeECDSASignatureDER(r, s []byte) []byte {
+	sig, _ := asn1.Marshal(asn1Signature{
+		R: new(big.Int).SetBytes(r),
+		S: new(big.Int).SetBytes(s),
+	})
+	return sig
+}
+
+// EncodeECDSASignatureCompact encodes r, s, v into the 65-byte Ethereum form
+// [R (32 bytes)][S (32 bytes)][V (1 byte)]. It is equivalent to
+// ComposeECDSASignature.
+func EncodeECDSASignatureCompact(r, s, v []byte) []byte {
+	return ComposeECDSASignature(r, s, v)
+}
+
+// DecodeECDSASignature decodes sig back into its r, s, v components,
+// auto-detecting the encoding from the leading byte: a DER signature starts
+// with the ASN.1 SEQUENCE tag (0x30), otherwise sig is treated as the
+// 65-byte compact [R||S||V] form. DER carries no recovery id, so v is
+// always 0 in that case.
+func DecodeECDSASignature(sig []byte) (r, s *big.Int, v byte, err error) {
+	if len(sig) > 0 && sig[0] == 0x30 {
+		var parsed asn1Signature
+		if _, err := asn1.Unmarshal(sig, &parsed); err != nil {
+			return nil, nil, 0, fmt.Errorf("invalid DER signature: %w", err)
+		}
+		return parsed.R, parsed.S, 0, nil
+	}
+
+	if len(sig) != 65 {
+		return nil, nil, 0, errors.New("invalid compact signature length, expected 65 bytes")
+	}
+	r = new(big.Int).SetBytes(sig[0:32])
+	s = new(big.Int).SetBytes(sig[32:64])
+	return r, s, sig[64], nil
+}
+
+// RecoverPubKey recovers the ECDSA public key that produced sig over
+// msgHash. sig must be in the 65-byte [R (32)][S (32)][V (1)] format
+// produced by ComposeECDSASignature. If the encoded recovery id does not
+// yield a point on the curve, the other recovery id is tried before giving
+// up.
+func RecoverPubKey(msgHash, sig []byte) (*ecdsa.PublicKey, error) {
+	if len(sig) != 65 {
+		return nil, errors.New("invalid signature length, expected 65 bytes")
+	}
+
+	recID := sig[64]
+	if recID >= 27 {
+		recID -= 27
+	}
+	if recID > 1 {
+		return nil, fmt.Errorf("invalid recovery id: %d", sig[64])
+	}
+
+	recover := func(id byte) (*ecdsa.PublicKey, error) {
+		compact := make([]byte, 65)
+		compact[0] = id + 27
+		copy(compact[1:33], sig[0:32])
+		copy(compact[33:65], sig[32:64])
+
+		pubKey, _, err := btcecdsa.RecoverCompact(compact, msgHash)
+		if err != nil {
+			return nil, err
+		}
+		ecdsaKey := pubKey.ToECDSA()
+		if !btcec.S256().IsOnCurve(ecdsaKey.X, ecdsaKey.Y) {
+			return nil, errors.New("recovered point not on secp256k1 curve")
+		}
+		return ecdsaKey, nil
+	}
+
+	if pubKey, err := recover(recID); err == nil {
+		return pubKey, nil
+	}
+	// The recovery id was ambiguous; fall back to the other candidate.
+	return recover(1 - recID)
+}
+
+// VerifySignature reports whether sig (in the [R||S||...] format produced by
+// ComposeECDSASignature) is a valid ECDSA signature over msgHash for
+// pubKey, the encoded public key format accepted by DecodeECDSAPubKey. This
+// lets an MPC caller confirm an assembled signature before broadcasting it,
+// catching malformed R/S from a misbehaving signer.
+func VerifySignature(pubKey, msgHash, sig []byte) bool {
+	key, err := DecodeECDSAPubKey(pubKey)
+	if err != nil {
+		return false
+	}
+	if len(sig) < 64 {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[0:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	return ecdsa.Verify(key, msgHash, r, s)
+}
+
+// ComposeEthereumSignature composes r, s, recovery into an Ethereum-style
+// signature, normalizing s to the curve's low-S half per EIP-2 (flipping the
+// recovery bit to compensate) and encoding V per EIP-155
+// (v = recovery + chainID*2 + 35) when eip155 is true, or the legacy scheme
+// (v = recovery + 27) otherwise. Unlike ComposeECDSASignature, V is not
+// truncated to a single byte, since EIP-155 V values grow with chainID.
+func ComposeEthereumSignature(r, s, recovery []byte, chainID *big.Int, eip155 bool) []byte {
+	sInt := new(big.Int).SetBytes(s)
+	recID := recovery[0]
+
+	n := btcec.S256().Params().N
+	if lowS := LowS(sInt, n); lowS.Cmp(sInt) != 0 {
+		sInt = lowS
+		recID ^= 1
+	}
+
+	var v *big.Int
+	if eip155 {
+		v = new(big.Int).Add(new(big.Int).Mul(chainID, big.NewInt(2)), big.NewInt(35+int64(recID)))
+	} else {
+		v = big.NewInt(27 + int64(recID))
+	}
+
+	sig := make([]byte, 64, 64+len(v.Bytes()))
+	copy(sig[0:32], new(big.Int).SetBytes(r).FillBytes(make([]byte, 32)))
+	copy(sig[32:64], sInt.FillBytes(make([]byte, 32)))
+	return append(sig, v.Bytes()...)
+}
+
+// DecomposeEthereumSignature is the inverse of ComposeEthereumSignature: it
+// splits sig into r, s and recovers the 0/1 recovery id from its trailing V,
+// interpreting V per EIP-155 when chainID is non-nil and positive, or per
+// the legacy scheme (v = recovery + 27) otherwise.
+func DecomposeEthereumSignature(sig []byte, chainID *big.Int) (r, s []byte, recovery byte, err error) {
+	if len(sig) < 65 {
+		return nil, nil, 0, errors.New("invalid signature length, expected at least 65 bytes")
+	}
+	r = sig[0:32]
+	s = sig[32:64]
+	v := new(big.Int).SetBytes(sig[64:])
+
+	if chainID != nil && chainID.Sign() > 0 {
+		offset := new(big.Int).Add(new(big.Int).Mul(chainID, big.NewInt(2)), big.NewInt(35))
+		recID := new(big.Int).Sub(v, offset)
+		if recID.Sign() != 0 && recID.Cmp(big.NewInt(1)) != 0 {
+			return nil, nil, 0, fmt.Errorf("invalid EIP-155 recovery id for chain %s: v=%s", chainID, v)
+		}
+		return r, s, byte(recID.Int64()), nil
+	}
+
+	recID := new(big.Int).Sub(v, big.NewInt(27))
+	if recID.Sign() != 0 && recID.Cmp(big.NewInt(1)) != 0 {
+		return nil, nil, 0, fmt.Errorf("invalid legacy recovery id: v=%s", v)
+	}
+	return r, s, byte(recID.Int64()), nil
+}
+
+// LowS returns s normalized to the curve's lower half, replacing it with
+// n-s when s > n/2. Bitcoin and Ethereum consensus rules reject high-S
+// signatures to prevent signature malleability.
+func LowS(s, n *big.Int) *big.Int {
+	halfN := new(big.Int).Rsh(n, 1)
+	if s.Cmp(halfN) > 0 {
+		return new(big.Int).Sub(n, s)
+	}
+	return new(big.Int).Set(s)
+}
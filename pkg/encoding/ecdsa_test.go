@@ -1,12 +1,15 @@
 package encoding
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"math/big"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -199,15 +202,12 @@ func TestEncodeS256PubKey(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, encoded)
 
-	// The encoded key should contain both X and Y coordinates appended together
-	xBytes := pubKey.X.Bytes()
-	yBytes := pubKey.Y.Bytes()
-	expectedLength := len(xBytes) + len(yBytes)
-	assert.Equal(t, expectedLength, len(encoded))
-
-	// Verify the encoded data contains the coordinates
-	assert.Equal(t, xBytes, encoded[:len(xBytes)])
-	assert.Equal(t, yBytes, encoded[len(xBytes):])
+	// The encoded key should be X and Y each left-padded to 32 bytes, so a
+	// round trip through DecodeECDSAPubKey works even when a coordinate has
+	// a leading zero byte.
+	assert.Len(t, encoded, 64)
+	assert.Equal(t, pubKey.X.FillBytes(make([]byte, 32)), encoded[:32])
+	assert.Equal(t, pubKey.Y.FillBytes(make([]byte, 32)), encoded[32:])
 }
 
 func TestEncodeS256PubKey_SpecificValues(t *testing.T) {
@@ -223,10 +223,10 @@ func TestEncodeS256PubKey_SpecificValues(t *testing.T) {
 	encoded, err := EncodeS256PubKey(pubKey)
 	require.NoError(t, err)
 
-	// Verify the encoding - should be X bytes followed by Y bytes
-	xBytes := x.Bytes()
-	yBytes := y.Bytes()
-	expected := append(xBytes, yBytes...)
+	// Verify the encoding - X and Y each left-padded to 32 bytes
+	expected := make([]byte, 64)
+	x.FillBytes(expected[0:32])
+	y.FillBytes(expected[32:64])
 
 	assert.Equal(t, expected, encoded)
 }
@@ -260,6 +260,151 @@ func TestEncodeS256PubKey_ZeroCoordinates(t *testing.T) {
 	encoded, err := EncodeS256PubKey(pubKey)
 	require.NoError(t, err)
 
-	// Should still work, though the result will be a very short byte array
-	assert.NotNil(t, encoded)
+	// Zero coordinates still encode to the full 64-byte width, all zero.
+	assert.Equal(t, make([]byte, 64), encoded)
+}
+
+func TestEncodeECDSASignatureDER_RoundTrip(t *testing.T) {
+	r := big.NewInt(0x1234)
+	s := big.NewInt(0x5678)
+
+	der := EncodeECDSASignatureDER(r.Bytes(), s.Bytes())
+	assert.Equal(t, byte(0x30), der[0], "DER signature should start with SEQUENCE tag")
+
+	decodedR, decodedS, v, err := DecodeECDSASignature(der)
+	require.NoError(t, err)
+	assert.Equal(t, r, decodedR)
+	assert.Equal(t, s, decodedS)
+	assert.Equal(t, byte(0), v, "DER signatures carry no recovery id")
+}
+
+func TestEncodeECDSASignatureCompact_MatchesCompose(t *testing.T) {
+	r := []byte{0x12, 0x34}
+	s := []byte{0x56, 0x78}
+	v := []byte{0x01}
+
+	assert.Equal(t, ComposeECDSASignature(r, s, v), EncodeECDSASignatureCompact(r, s, v))
+}
+
+func TestDecodeECDSASignature_Compact(t *testing.T) {
+	r := big.NewInt(0xaabb)
+	s := big.NewInt(0xccdd)
+	sig := ComposeECDSASignature(r.Bytes(), s.Bytes(), []byte{0x01})
+
+	decodedR, decodedS, v, err := DecodeECDSASignature(sig)
+	require.NoError(t, err)
+	assert.Equal(t, r, decodedR)
+	assert.Equal(t, s, decodedS)
+	assert.Equal(t, byte(0x01), v)
+}
+
+func TestDecodeECDSASignature_InvalidLength(t *testing.T) {
+	_, _, _, err := DecodeECDSASignature([]byte{0x01, 0x02, 0x03})
+	assert.Error(t, err)
+}
+
+func TestRecoverPubKey(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	msgHash := make([]byte, 32)
+	copy(msgHash, []byte("deterministic-test-message-hash"))
+
+	compact, err := btcecdsa.SignCompact(privKey, msgHash, false)
+	require.NoError(t, err)
+	recID := compact[0] - 27
+	sig := ComposeECDSASignature(compact[1:33], compact[33:65], []byte{recID})
+
+	recovered, err := RecoverPubKey(msgHash, sig)
+	require.NoError(t, err)
+
+	pubKey := privKey.PubKey().ToECDSA()
+	assert.Equal(t, pubKey.X, recovered.X)
+	assert.Equal(t, pubKey.Y, recovered.Y)
+}
+
+func TestRecoverPubKey_InvalidLength(t *testing.T) {
+	_, err := RecoverPubKey(make([]byte, 32), []byte{0x01})
+	assert.Error(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	msgHash := make([]byte, 32)
+	copy(msgHash, []byte("deterministic-test-message-hash"))
+
+	compact, err := btcecdsa.SignCompact(privKey, msgHash, false)
+	require.NoError(t, err)
+	recID := compact[0] - 27
+	sig := ComposeECDSASignature(compact[1:33], compact[33:65], []byte{recID})
+
+	pubKey, err := EncodeS256PubKey(privKey.PubKey().ToECDSA())
+	require.NoError(t, err)
+
+	assert.True(t, VerifySignature(pubKey, msgHash, sig))
+
+	otherHash := make([]byte, 32)
+	copy(otherHash, []byte("a completely different message!"))
+	assert.False(t, VerifySignature(pubKey, otherHash, sig))
+}
+
+func TestComposeEthereumSignature_Legacy(t *testing.T) {
+	r := big.NewInt(0x1234).Bytes()
+	s := big.NewInt(0x5678).Bytes()
+
+	sig := ComposeEthereumSignature(r, s, []byte{0x00}, nil, false)
+	require.Len(t, sig, 65)
+	assert.Equal(t, byte(27), sig[64], "legacy V should be recovery+27")
+
+	decodedR, decodedS, recovery, err := DecomposeEthereumSignature(sig, nil)
+	require.NoError(t, err)
+	assert.Equal(t, r, bytes.TrimLeft(decodedR, "\x00"))
+	assert.Equal(t, s, bytes.TrimLeft(decodedS, "\x00"))
+	assert.Equal(t, byte(0x00), recovery)
+}
+
+func TestComposeEthereumSignature_EIP155(t *testing.T) {
+	chainID := big.NewInt(1) // mainnet
+	r := big.NewInt(0xaabb).Bytes()
+	s := big.NewInt(0xccdd).Bytes()
+
+	sig := ComposeEthereumSignature(r, s, []byte{0x01}, chainID, true)
+	// v = 1 + 1*2 + 35 = 38
+	assert.Equal(t, []byte{38}, sig[64:])
+
+	_, _, recovery, err := DecomposeEthereumSignature(sig, chainID)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x01), recovery)
+}
+
+func TestComposeEthereumSignature_NormalizesHighS(t *testing.T) {
+	n := btcec.S256().Params().N
+	halfN := new(big.Int).Rsh(n, 1)
+	highS := new(big.Int).Add(halfN, big.NewInt(1))
+
+	sig := ComposeEthereumSignature(big.NewInt(1).Bytes(), highS.Bytes(), []byte{0x00}, nil, false)
+
+	normalizedS := new(big.Int).SetBytes(sig[32:64])
+	assert.True(t, normalizedS.Cmp(halfN) <= 0, "s should be normalized to the low half")
+	assert.Equal(t, byte(28), sig[64], "recovery bit should flip when s is normalized")
+}
+
+func TestDecomposeEthereumSignature_InvalidLength(t *testing.T) {
+	_, _, _, err := DecomposeEthereumSignature(make([]byte, 10), nil)
+	assert.Error(t, err)
+}
+
+func TestLowS(t *testing.T) {
+	n := btcec.S256().Params().N
+	halfN := new(big.Int).Rsh(n, 1)
+
+	low := big.NewInt(100)
+	assert.Equal(t, low, LowS(low, n), "s already low should be unchanged")
+
+	high := new(big.Int).Add(halfN, big.NewInt(1))
+	normalized := LowS(high, n)
+	assert.Equal(t, new(big.Int).Sub(n, high), normalized)
+	assert.True(t, normalized.Cmp(halfN) <= 0, "normalized s should be in the low half")
 }
@@ -0,0 +1,97 @@
+package encoding
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+)
+
+// EncodeEDDSAPubKey encodes an Ed25519 public key in compressed form (32
+// bytes).
+func EncodeEDDSAPubKey(pubKey *edwards.PublicKey) ([]byte, error) {
+	return pubKey.SerializeCompressed(), nil
+}
+
+// DecodeEDDSAPubKey decodes a 32-byte compressed Ed25519 public key.
+func DecodeEDDSAPubKey(encodedKey []byte) (*edwards.PublicKey, error) {
+	pubKey, err := edwards.ParsePubKey(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encoded EdDSA public key: %w", err)
+	}
+	return pubKey, nil
+}
+
+// ComposeEDDSASignature composes R, S into the 64-byte Ed25519 signature
+// layout documented on SigningResponse: [R (32 bytes)][S (32 bytes)], where
+// R is the encoded curve point and S is the scalar signature component.
+func ComposeEDDSASignature(r, s []byte) []byte {
+	sig := make([]byte, 64)
+	copy(sig[0:32], r)
+	copy(sig[32:64], s)
+	return sig
+}
+
+// VerifyEDDSASignature reports whether sig is a valid EdDSA signature over
+// msg for pubKey. It decodes R as an encoded curve point (not just an
+// x-coordinate, per the SigningResponse comment), rejects non-canonical S
+// per RFC 8032 §5.1.7, and checks the cofactored verification equation
+// [8]SB = [8]R + [8]H(R,A,m)A. Every signature that passes the plain (non
+// cofactored) equation also passes this one; small-order components of R
+// or A are ignored rather than rejected.
+func VerifyEDDSASignature(pubKey, msg, sig []byte) bool {
+	if len(sig) != 64 {
+		return false
+	}
+
+	curve := edwards.Edwards()
+
+	a, err := edwards.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+	r, err := edwards.ParsePubKey(sig[0:32])
+	if err != nil {
+		return false
+	}
+
+	s := leToBigInt(sig[32:64])
+	if s.Cmp(curve.Params().N) >= 0 {
+		return false // non-canonical S
+	}
+
+	h := hashRAM(sig[0:32], pubKey, msg, curve.Params().N)
+
+	sbX, sbY := curve.ScalarBaseMult(s.Bytes())
+	haX, haY := curve.ScalarMult(a.X, a.Y, h.Bytes())
+	rhaX, rhaY := curve.Add(r.X, r.Y, haX, haY)
+
+	// Multiply both sides by the cofactor (8 = 2^3) via three doublings.
+	for i := 0; i < 3; i++ {
+		sbX, sbY = curve.Double(sbX, sbY)
+		rhaX, rhaY = curve.Double(rhaX, rhaY)
+	}
+
+	return sbX.Cmp(rhaX) == 0 && sbY.Cmp(rhaY) == 0
+}
+
+// hashRAM computes SHA-512(R || A || M) reduced mod n, the per-signature
+// challenge scalar used in Ed25519 verification.
+func hashRAM(r, a, msg []byte, n *big.Int) *big.Int {
+	h := sha512.New()
+	h.Write(r)
+	h.Write(a)
+	h.Write(msg)
+	return new(big.Int).Mod(leToBigInt(h.Sum(nil)), n)
+}
+
+// leToBigInt interprets b as a little-endian unsigned integer, the
+// convention RFC 8032 uses for encoded scalars and hash digests.
+func leToBigInt(b []byte) *big.Int {
+	be := make([]byte, len(b))
+	for i, v := range b {
+		be[len(b)-1-i] = v
+	}
+	return new(big.Int).SetBytes(be)
+}
@@ -62,6 +62,49 @@ func TestDecodeEDDSAPubKey_EmptyData(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestComposeEDDSASignature(t *testing.T) {
+	r := make([]byte, 32)
+	s := make([]byte, 32)
+	r[0], s[0] = 0xaa, 0xbb
+
+	sig := ComposeEDDSASignature(r, s)
+	require.Len(t, sig, 64)
+	assert.Equal(t, r, sig[0:32])
+	assert.Equal(t, s, sig[32:64])
+}
+
+func TestVerifyEDDSASignature(t *testing.T) {
+	privateKey, err := edwards.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	pubKey, err := EncodeEDDSAPubKey(privateKey.PubKey())
+	require.NoError(t, err)
+
+	msg := []byte("mpc eddsa signing response")
+	r, s, err := edwards.Sign(privateKey, msg)
+	require.NoError(t, err)
+
+	// edwards.Sign returns R/S as big-endian big.Ints; the wire encoding
+	// ComposeEDDSASignature/VerifyEDDSASignature expect is the standard
+	// little-endian Ed25519 encoding produced by Signature.Serialize.
+	serialized := (&edwards.Signature{R: r, S: s}).Serialize()
+	sig := ComposeEDDSASignature(serialized[0:32], serialized[32:64])
+	assert.Equal(t, serialized, sig)
+
+	assert.True(t, VerifyEDDSASignature(pubKey, msg, sig))
+	assert.False(t, VerifyEDDSASignature(pubKey, []byte("a different message"), sig), "tampered message should fail")
+}
+
+func TestVerifyEDDSASignature_InvalidLength(t *testing.T) {
+	privateKey, err := edwards.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	pubKey, err := EncodeEDDSAPubKey(privateKey.PubKey())
+	require.NoError(t, err)
+
+	assert.False(t, VerifyEDDSASignature(pubKey, []byte("msg"), make([]byte, 63)))
+}
+
 func TestEncodeDecodeEDDSA_RoundTrip(t *testing.T) {
 	// Test multiple round trips to ensure consistency
 	for i := 0; i < 10; i++ {
@@ -0,0 +1,141 @@
+package hdkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+
+	"github.com/iamnivekx/mpc-go/pkg/encoding"
+)
+
+// HardenedOffset is added to an index to mark it as hardened in a BIP-32
+// derivation path.
+const HardenedOffset = uint32(0x80000000)
+
+// DerivePublicChild derives the non-hardened child public key and chain
+// code at index from parentPub/chainCode per BIP-32's CKDpub:
+//
+//	I = HMAC-SHA512(chainCode, serP(parentPub) || ser32(index))
+//	IL, IR = I[:32], I[32:]
+//	childPub = parentPub + IL*G
+//	childChainCode = IR
+//
+// parentPub and childPub are in the encoding package's format (as produced
+// by encoding.EncodeS256PubKey / accepted by encoding.DecodeECDSAPubKey),
+// not raw SEC1; serP above refers only to the BIP-32 HMAC input, which is
+// always compressed SEC1 regardless of this module's storage format.
+//
+// Hardened derivation (index >= HardenedOffset) is not supported here, since
+// it requires the parent private key.
+func DerivePublicChild(parentPub, chainCode []byte, index uint32) (childPub, childChainCode []byte, err error) {
+	if index >= HardenedOffset {
+		return nil, nil, errors.New("hdkey: hardened derivation requires the parent private key")
+	}
+
+	parent, err := encoding.DecodeECDSAPubKey(parentPub)
+	if err != nil {
+		return nil, nil, fmt.Errorf("hdkey: invalid parent public key: %w", err)
+	}
+
+	data := make([]byte, 0, 37)
+	data = append(data, serP(parent)...)
+	data = append(data, ser32(index)...)
+
+	mac := hmac.New(sha512.New, chainCode)
+	mac.Write(data)
+	i := mac.Sum(nil)
+	il, ir := i[:32], i[32:]
+
+	curve := btcec.S256()
+	ilInt := new(big.Int).SetBytes(il)
+	if ilInt.Cmp(curve.Params().N) >= 0 {
+		return nil, nil, errors.New("hdkey: invalid derivation, IL >= curve order")
+	}
+
+	ilX, ilY := curve.ScalarBaseMult(il)
+	childX, childY := curve.Add(parent.X, parent.Y, ilX, ilY)
+	if childX.Sign() == 0 && childY.Sign() == 0 {
+		return nil, nil, errors.New("hdkey: invalid derivation, resulting point is at infinity")
+	}
+
+	childPub, err = encoding.EncodeS256PubKey(&ecdsa.PublicKey{Curve: curve, X: childX, Y: childY})
+	if err != nil {
+		return nil, nil, err
+	}
+	return childPub, ir, nil
+}
+
+// serP encodes pub as a 33-byte SEC1 compressed point, BIP-32's serP. This
+// is the standard CKDpub wire serialization and is independent of
+// encoding.EncodeS256PubKey, which is this module's own storage format.
+func serP(pub *ecdsa.PublicKey) []byte {
+	out := make([]byte, 33)
+	if pub.Y.Bit(0) == 0 {
+		out[0] = 0x02
+	} else {
+		out[0] = 0x03
+	}
+	pub.X.FillBytes(out[1:])
+	return out
+}
+
+// ser32 encodes i as a 4-byte big-endian integer, BIP-32's ser32.
+func ser32(i uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, i)
+	return b
+}
+
+// Path is a parsed BIP-32/BIP-44 derivation path, e.g. m/44'/60'/0'/0/3.
+type Path []uint32
+
+// ParsePath parses a derivation path string such as "m/44'/60'/0'/0/0". A
+// trailing "'", "h" or "H" marks a hardened index.
+func ParsePath(path string) (Path, error) {
+	segments := strings.Split(path, "/")
+	if len(segments) == 0 || segments[0] != "m" {
+		return nil, fmt.Errorf("hdkey: invalid derivation path %q: must start with \"m\"", path)
+	}
+
+	result := make(Path, 0, len(segments)-1)
+	for _, seg := range segments[1:] {
+		if seg == "" {
+			return nil, fmt.Errorf("hdkey: invalid derivation path %q: empty segment", path)
+		}
+		hardened := strings.ContainsAny(seg[len(seg)-1:], "'hH")
+		seg = strings.TrimRight(seg, "'hH")
+
+		index, err := strconv.ParseUint(seg, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("hdkey: invalid derivation path segment %q: %w", seg, err)
+		}
+		if hardened {
+			index += uint64(HardenedOffset)
+		}
+		result = append(result, uint32(index))
+	}
+	return result, nil
+}
+
+// DerivePath walks path from parentPub/chainCode, applying
+// DerivePublicChild at each index. It fails if path contains a hardened
+// index, since hardened children cannot be derived from a public key
+// alone.
+func DerivePath(parentPub, chainCode []byte, path Path) (childPub, childChainCode []byte, err error) {
+	childPub, childChainCode = parentPub, chainCode
+	for _, index := range path {
+		childPub, childChainCode, err = DerivePublicChild(childPub, childChainCode, index)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return childPub, childChainCode, nil
+}
@@ -0,0 +1,156 @@
+package hdkey
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iamnivekx/mpc-go/pkg/encoding"
+)
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    Path
+		wantErr bool
+	}{
+		{
+			name: "bip44 ethereum path",
+			path: "m/44'/60'/0'/0/3",
+			want: Path{44 + HardenedOffset, 60 + HardenedOffset, 0 + HardenedOffset, 0, 3},
+		},
+		{
+			name: "lowercase h hardened marker",
+			path: "m/44h/0h/0h/0/0",
+			want: Path{44 + HardenedOffset, 0 + HardenedOffset, 0 + HardenedOffset, 0, 0},
+		},
+		{
+			name: "root only",
+			path: "m",
+			want: Path{},
+		},
+		{
+			name:    "missing m prefix",
+			path:    "44'/60'/0'/0/0",
+			wantErr: true,
+		},
+		{
+			name:    "empty segment",
+			path:    "m/44'//0",
+			wantErr: true,
+		},
+		{
+			name:    "non-numeric segment",
+			path:    "m/abc",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePath(tt.path)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDerivePublicChild_RejectsHardenedIndex(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	parentPub, err := encoding.EncodeS256PubKey(&privKey.ToECDSA().PublicKey)
+	require.NoError(t, err)
+	chainCode := make([]byte, 32)
+
+	_, _, err = DerivePublicChild(parentPub, chainCode, HardenedOffset)
+	assert.Error(t, err, "hardened index should be rejected")
+}
+
+func TestDerivePublicChild_Deterministic(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	parentPub, err := encoding.EncodeS256PubKey(&privKey.ToECDSA().PublicKey)
+	require.NoError(t, err)
+	chainCode := make([]byte, 32)
+	for i := range chainCode {
+		chainCode[i] = byte(i)
+	}
+
+	childPub1, childChainCode1, err := DerivePublicChild(parentPub, chainCode, 0)
+	require.NoError(t, err)
+
+	childPub2, childChainCode2, err := DerivePublicChild(parentPub, chainCode, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, childPub1, childPub2)
+	assert.Equal(t, childChainCode1, childChainCode2)
+	assert.NotEqual(t, parentPub, childPub1)
+}
+
+func TestDerivePublicChild_DifferentIndicesDiffer(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	parentPub, err := encoding.EncodeS256PubKey(&privKey.ToECDSA().PublicKey)
+	require.NoError(t, err)
+	chainCode := make([]byte, 32)
+
+	childPub0, _, err := DerivePublicChild(parentPub, chainCode, 0)
+	require.NoError(t, err)
+
+	childPub1, _, err := DerivePublicChild(parentPub, chainCode, 1)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, childPub0, childPub1)
+}
+
+func TestDerivePath(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	parentPub, err := encoding.EncodeS256PubKey(&privKey.ToECDSA().PublicKey)
+	require.NoError(t, err)
+	chainCode := make([]byte, 32)
+
+	path, err := ParsePath("m/0/0/3")
+	require.NoError(t, err)
+
+	childPub, childChainCode, err := DerivePath(parentPub, chainCode, path)
+	require.NoError(t, err)
+	assert.NotEmpty(t, childPub)
+	assert.NotEmpty(t, childChainCode)
+
+	expectedPub, expectedChainCode, err := DerivePublicChild(parentPub, chainCode, 0)
+	require.NoError(t, err)
+	expectedPub, expectedChainCode, err = DerivePublicChild(expectedPub, expectedChainCode, 0)
+	require.NoError(t, err)
+	expectedPub, expectedChainCode, err = DerivePublicChild(expectedPub, expectedChainCode, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedPub, childPub)
+	assert.Equal(t, expectedChainCode, childChainCode)
+}
+
+func TestDerivePath_HardenedSegmentFails(t *testing.T) {
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	parentPub, err := encoding.EncodeS256PubKey(&privKey.ToECDSA().PublicKey)
+	require.NoError(t, err)
+	chainCode := make([]byte, 32)
+
+	path, err := ParsePath("m/44'/0/0")
+	require.NoError(t, err)
+
+	_, _, err = DerivePath(parentPub, chainCode, path)
+	assert.Error(t, err)
+}
@@ -0,0 +1,43 @@
+package transport
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// maxFrameSize bounds a single framed message, guarding against a
+// corrupt or malicious peer claiming an unbounded length prefix.
+const maxFrameSize = 16 * 1024 * 1024 // 16 MiB
+
+// writeFrame writes payload to w prefixed with its big-endian uint32
+// length.
+func writeFrame(w io.Writer, payload []byte) error {
+	if len(payload) > maxFrameSize {
+		return errors.New("transport: frame exceeds maximum size")
+	}
+	header := make([]byte, 4)
+	binary.BigEndian.PutUint32(header, uint32(len(payload)))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// readFrame reads a length-prefixed payload from r.
+func readFrame(r io.Reader) ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(header)
+	if length > maxFrameSize {
+		return nil, errors.New("transport: frame exceeds maximum size")
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
@@ -0,0 +1,42 @@
+package transport
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteReadFrame_RoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	payload := []byte("threshold round message")
+
+	require.NoError(t, writeFrame(&buf, payload))
+
+	got, err := readFrame(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, payload, got)
+}
+
+func TestWriteReadFrame_Empty(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, writeFrame(&buf, nil))
+
+	got, err := readFrame(&buf)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestWriteFrame_RejectsOversized(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeFrame(&buf, make([]byte, maxFrameSize+1))
+	assert.Error(t, err)
+}
+
+func TestReadFrame_TruncatedHeader(t *testing.T) {
+	buf := bytes.NewBuffer([]byte{0x00, 0x01})
+	_, err := readFrame(buf)
+	assert.Error(t, err)
+}
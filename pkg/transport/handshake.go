@@ -0,0 +1,111 @@
+package transport
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+
+	"github.com/iamnivekx/mpc-go/pkg/encoding"
+)
+
+// IdentityKeyPair is a party's long-term secp256k1 identity key, used to
+// authenticate the handshake. Public is the same encoded form produced by
+// encoding.EncodeS256PubKey, and is validated on the peer side with
+// encoding.DecodeECDSAPubKey.
+type IdentityKeyPair struct {
+	Private *ecdsa.PrivateKey
+	Public  []byte
+}
+
+// handshakeMessage is exchanged by both sides before any SigningMessage
+// traffic: each party sends its static identity public key plus a fresh
+// ephemeral public key, signed with the identity private key to prove
+// possession of it, then both derive a shared session key via ECDH over
+// the ephemeral keys.
+type handshakeMessage struct {
+	IdentityPub  []byte
+	EphemeralPub []byte
+	// Signature is an ECDSA signature, in the DER form produced by
+	// encoding.EncodeECDSASignatureDER, over SHA-256(EphemeralPub) made
+	// with the identity private key.
+	Signature []byte
+}
+
+// generateEphemeralKey creates a fresh secp256k1 key pair for one
+// handshake.
+func generateEphemeralKey() (*ecdsa.PrivateKey, error) {
+	priv, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return priv.ToECDSA(), nil
+}
+
+// signEphemeralKey proves possession of identity's private key by signing
+// SHA-256(ephemeralPub) with it.
+func signEphemeralKey(identity *ecdsa.PrivateKey, ephemeralPub []byte) ([]byte, error) {
+	digest := sha256.Sum256(ephemeralPub)
+	r, s, err := ecdsa.Sign(rand.Reader, identity, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("transport: sign ephemeral key: %w", err)
+	}
+	return encoding.EncodeECDSASignatureDER(r.Bytes(), s.Bytes()), nil
+}
+
+// verifyIdentity validates a peer-presented identity key (the same on-curve
+// check performed for encoded MPC group public keys), optionally pinning it
+// against expectedIdentity, and checks that msg.Signature proves possession
+// of the corresponding private key over msg.EphemeralPub. Without this, any
+// party on the network could claim to be any other signer and complete a
+// handshake without holding that signer's key.
+func verifyIdentity(msg handshakeMessage, expectedIdentity []byte) (*ecdsa.PublicKey, error) {
+	if expectedIdentity != nil && !bytes.Equal(msg.IdentityPub, expectedIdentity) {
+		return nil, errors.New("transport: peer identity does not match expected identity")
+	}
+
+	key, err := encoding.DecodeECDSAPubKey(msg.IdentityPub)
+	if err != nil {
+		return nil, fmt.Errorf("transport: invalid peer identity: %w", err)
+	}
+
+	r, s, _, err := encoding.DecodeECDSASignature(msg.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("transport: invalid handshake signature: %w", err)
+	}
+	digest := sha256.Sum256(msg.EphemeralPub)
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		return nil, errors.New("transport: peer did not prove possession of its identity key")
+	}
+
+	return key, nil
+}
+
+// deriveSessionKey runs ECDH on the ephemeral key pair and derives a
+// 32-byte AES-256-GCM key as HMAC-SHA256, keyed with the shared secret,
+// over both parties' identity keys so a transcript from a different pairing
+// can't be replayed.
+func deriveSessionKey(ephemeralPriv *ecdsa.PrivateKey, peerEphemeralPub *ecdsa.PublicKey, localIdentity, peerIdentity []byte) ([]byte, error) {
+	if !peerEphemeralPub.Curve.IsOnCurve(peerEphemeralPub.X, peerEphemeralPub.Y) {
+		return nil, errors.New("transport: peer ephemeral key not on curve")
+	}
+
+	sx, _ := peerEphemeralPub.Curve.ScalarMult(peerEphemeralPub.X, peerEphemeralPub.Y, ephemeralPriv.D.Bytes())
+
+	mac := hmac.New(sha256.New, sx.Bytes())
+	// Order identity keys deterministically so both sides derive the same
+	// key regardless of which side dialed.
+	if string(localIdentity) < string(peerIdentity) {
+		mac.Write(localIdentity)
+		mac.Write(peerIdentity)
+	} else {
+		mac.Write(peerIdentity)
+		mac.Write(localIdentity)
+	}
+	return mac.Sum(nil), nil
+}
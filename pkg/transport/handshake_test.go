@@ -0,0 +1,136 @@
+package transport
+
+import (
+	"crypto/cipher"
+	"net"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iamnivekx/mpc-go/pkg/encoding"
+)
+
+func newTestIdentity(t *testing.T) *IdentityKeyPair {
+	t.Helper()
+	priv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	ecdsaPriv := priv.ToECDSA()
+	pub, err := encoding.EncodeS256PubKey(&ecdsaPriv.PublicKey)
+	require.NoError(t, err)
+
+	return &IdentityKeyPair{Private: ecdsaPriv, Public: pub}
+}
+
+func TestHandshake_Success(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	clientIdentity := newTestIdentity(t)
+	serverIdentity := newTestIdentity(t)
+
+	type result struct {
+		aead cipher.AEAD
+		err  error
+	}
+	clientCh := make(chan result, 1)
+	serverCh := make(chan result, 1)
+
+	go func() {
+		aead, err := handshake(clientConn, clientIdentity, serverIdentity.Public)
+		clientCh <- result{aead, err}
+	}()
+	go func() {
+		aead, err := handshake(serverConn, serverIdentity, clientIdentity.Public)
+		serverCh <- result{aead, err}
+	}()
+
+	clientResult := <-clientCh
+	serverResult := <-serverCh
+	require.NoError(t, clientResult.err)
+	require.NoError(t, serverResult.err)
+
+	// Both sides must have derived the same session key: a message sealed
+	// by one side's AEAD has to be openable by the other's.
+	nonce := make([]byte, clientResult.aead.NonceSize())
+	sealed := clientResult.aead.Seal(nil, nonce, []byte("round trip"), nil)
+	opened, err := serverResult.aead.Open(nil, nonce, sealed, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("round trip"), opened)
+}
+
+func TestHandshake_RejectsWrongIdentity(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	defer serverConn.Close()
+
+	clientIdentity := newTestIdentity(t)
+	serverIdentity := newTestIdentity(t)
+	wrongIdentity := newTestIdentity(t)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := handshake(serverConn, serverIdentity, clientIdentity.Public)
+		errCh <- err
+	}()
+
+	// The client pins an identity other than the server's real one.
+	_, clientErr := handshake(clientConn, clientIdentity, wrongIdentity.Public)
+	assert.Error(t, clientErr)
+	<-errCh
+}
+
+func TestVerifyIdentity_AcceptsValidSignature(t *testing.T) {
+	identity := newTestIdentity(t)
+
+	ephemeralPriv, err := generateEphemeralKey()
+	require.NoError(t, err)
+	ephemeralPub, err := encoding.EncodeS256PubKey(&ephemeralPriv.PublicKey)
+	require.NoError(t, err)
+
+	signature, err := signEphemeralKey(identity.Private, ephemeralPub)
+	require.NoError(t, err)
+
+	msg := handshakeMessage{IdentityPub: identity.Public, EphemeralPub: ephemeralPub, Signature: signature}
+	key, err := verifyIdentity(msg, identity.Public)
+	require.NoError(t, err)
+	assert.Equal(t, identity.Private.PublicKey.X, key.X)
+}
+
+func TestVerifyIdentity_RejectsForgedSignature(t *testing.T) {
+	victim := newTestIdentity(t)
+	attacker := newTestIdentity(t)
+
+	ephemeralPriv, err := generateEphemeralKey()
+	require.NoError(t, err)
+	ephemeralPub, err := encoding.EncodeS256PubKey(&ephemeralPriv.PublicKey)
+	require.NoError(t, err)
+
+	// The attacker signs with its own key but claims the victim's identity.
+	signature, err := signEphemeralKey(attacker.Private, ephemeralPub)
+	require.NoError(t, err)
+
+	forged := handshakeMessage{IdentityPub: victim.Public, EphemeralPub: ephemeralPub, Signature: signature}
+	_, err = verifyIdentity(forged, nil)
+	assert.Error(t, err, "a signature from a different key must not validate as the claimed identity")
+}
+
+func TestVerifyIdentity_RejectsMismatchedExpectedIdentity(t *testing.T) {
+	identity := newTestIdentity(t)
+	other := newTestIdentity(t)
+
+	ephemeralPriv, err := generateEphemeralKey()
+	require.NoError(t, err)
+	ephemeralPub, err := encoding.EncodeS256PubKey(&ephemeralPriv.PublicKey)
+	require.NoError(t, err)
+
+	signature, err := signEphemeralKey(identity.Private, ephemeralPub)
+	require.NoError(t, err)
+
+	msg := handshakeMessage{IdentityPub: identity.Public, EphemeralPub: ephemeralPub, Signature: signature}
+	_, err = verifyIdentity(msg, other.Public)
+	assert.Error(t, err)
+}
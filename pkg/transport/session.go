@@ -0,0 +1,241 @@
+package transport
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/iamnivekx/mpc-go/pkg/encoding"
+	"github.com/iamnivekx/mpc-go/pkg/types"
+)
+
+// ReconnectPolicy controls how a Session retries a dropped connection.
+type ReconnectPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+}
+
+// DefaultReconnectPolicy retries a handful of times with a short linear
+// backoff, enough to ride out a transient network blip mid-round without
+// stalling a threshold ceremony for long.
+var DefaultReconnectPolicy = ReconnectPolicy{
+	MaxAttempts: 5,
+	Backoff:     500 * time.Millisecond,
+}
+
+// Session is an authenticated, encrypted framed connection between two MPC
+// parties, carrying SigningMessage/SigningResponse traffic for one or more
+// in-flight transactions identified by TxID. A dropped TCP connection is
+// transparently re-dialed and re-handshaken per its ReconnectPolicy, and
+// in-flight TxIDs survive the reconnect so the caller can resume an
+// interrupted signing round.
+type Session struct {
+	mu               sync.Mutex
+	conn             net.Conn
+	aead             cipher.AEAD
+	identity         *IdentityKeyPair
+	expectedIdentity []byte
+	dial             func() (net.Conn, error)
+	policy           ReconnectPolicy
+	inFlight         map[string]struct{}
+}
+
+// Dial establishes a Session to addr, performing the identity/ECDH
+// handshake and deriving the frame encryption key. expectedPeerIdentity is
+// the encoded identity public key (as produced by encoding.EncodeS256PubKey)
+// the caller expects to be talking to; the handshake fails if the peer
+// presents a different identity or cannot prove possession of it.
+func Dial(addr string, identity *IdentityKeyPair, expectedPeerIdentity []byte, policy ReconnectPolicy) (*Session, error) {
+	dial := func() (net.Conn, error) { return net.Dial("tcp", addr) }
+
+	conn, err := dial()
+	if err != nil {
+		return nil, fmt.Errorf("transport: dial %s: %w", addr, err)
+	}
+
+	aead, err := handshake(conn, identity, expectedPeerIdentity)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &Session{
+		conn:             conn,
+		aead:             aead,
+		identity:         identity,
+		expectedIdentity: expectedPeerIdentity,
+		dial:             dial,
+		policy:           policy,
+		inFlight:         make(map[string]struct{}),
+	}, nil
+}
+
+// handshake runs the identity/ECDH exchange over conn and returns the
+// resulting AES-GCM AEAD used to seal/open frames. expectedPeerIdentity, if
+// non-nil, pins the peer to that identity.
+func handshake(conn net.Conn, identity *IdentityKeyPair, expectedPeerIdentity []byte) (cipher.AEAD, error) {
+	ephemeralPriv, err := generateEphemeralKey()
+	if err != nil {
+		return nil, fmt.Errorf("transport: generate ephemeral key: %w", err)
+	}
+	ephemeralPub, err := encoding.EncodeS256PubKey(&ephemeralPriv.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signEphemeralKey(identity.Private, ephemeralPub)
+	if err != nil {
+		return nil, err
+	}
+
+	local, err := json.Marshal(handshakeMessage{
+		IdentityPub:  identity.Public,
+		EphemeralPub: ephemeralPub,
+		Signature:    signature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transport: marshal handshake: %w", err)
+	}
+
+	// Write and read concurrently: both sides send their handshake message
+	// before either has read the peer's, so writing synchronously first (as
+	// net.Pipe's unbuffered Write blocks until a corresponding Read) would
+	// deadlock with both ends stuck in their own write.
+	writeErrCh := make(chan error, 1)
+	go func() { writeErrCh <- writeFrame(conn, local) }()
+
+	raw, readErr := readFrame(conn)
+	if writeErr := <-writeErrCh; writeErr != nil {
+		return nil, fmt.Errorf("transport: send handshake: %w", writeErr)
+	}
+	if readErr != nil {
+		return nil, fmt.Errorf("transport: recv handshake: %w", readErr)
+	}
+	var peer handshakeMessage
+	if err := json.Unmarshal(raw, &peer); err != nil {
+		return nil, fmt.Errorf("transport: decode handshake: %w", err)
+	}
+
+	if _, err := verifyIdentity(peer, expectedPeerIdentity); err != nil {
+		return nil, err
+	}
+	peerEphemeral, err := encoding.DecodeECDSAPubKey(peer.EphemeralPub)
+	if err != nil {
+		return nil, fmt.Errorf("transport: invalid peer ephemeral key: %w", err)
+	}
+
+	sessionKey, err := deriveSessionKey(ephemeralPriv, peerEphemeral, identity.Public, peer.IdentityPub)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Send encrypts and frames msg, reconnecting per policy if the underlying
+// connection has dropped.
+func (s *Session) Send(msg types.SigningMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inFlight[msg.TxID] = struct{}{}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("transport: marshal message: %w", err)
+	}
+	return s.sendSealed(payload)
+}
+
+// Recv blocks for the next SigningResponse, reconnecting per policy if the
+// underlying connection has dropped.
+func (s *Session) Recv() (types.SigningResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, err := s.recvSealed()
+	if err != nil {
+		return types.SigningResponse{}, err
+	}
+
+	var resp types.SigningResponse
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return types.SigningResponse{}, fmt.Errorf("transport: unmarshal response: %w", err)
+	}
+	delete(s.inFlight, resp.TxID)
+	return resp, nil
+}
+
+// Close closes the underlying connection.
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
+
+func (s *Session) sendSealed(payload []byte) error {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("transport: generate nonce: %w", err)
+	}
+	sealed := s.aead.Seal(nonce, nonce, payload, nil)
+
+	if err := writeFrame(s.conn, sealed); err != nil {
+		if !s.reconnect() {
+			return fmt.Errorf("transport: send failed and reconnect exhausted: %w", err)
+		}
+		return s.sendSealed(payload)
+	}
+	return nil
+}
+
+func (s *Session) recvSealed() ([]byte, error) {
+	sealed, err := readFrame(s.conn)
+	if err != nil {
+		if !s.reconnect() {
+			return nil, fmt.Errorf("transport: recv failed and reconnect exhausted: %w", err)
+		}
+		return s.recvSealed()
+	}
+
+	nonceSize := s.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, errors.New("transport: frame too short to contain nonce")
+	}
+	nonce, ciphertext := sealed[:nonceSize], sealed[nonceSize:]
+	return s.aead.Open(nil, nonce, ciphertext, nil)
+}
+
+// reconnect re-dials and re-runs the handshake, retrying per s.policy. Any
+// in-flight TxIDs are left recorded so the caller can resend them once the
+// new connection is up, resuming a threshold ceremony that survived the
+// drop.
+func (s *Session) reconnect() bool {
+	s.conn.Close()
+
+	for attempt := 0; attempt < s.policy.MaxAttempts; attempt++ {
+		time.Sleep(s.policy.Backoff * time.Duration(attempt+1))
+
+		conn, err := s.dial()
+		if err != nil {
+			continue
+		}
+		aead, err := handshake(conn, s.identity, s.expectedIdentity)
+		if err != nil {
+			conn.Close()
+			continue
+		}
+
+		s.conn = conn
+		s.aead = aead
+		return true
+	}
+	return false
+}
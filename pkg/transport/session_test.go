@@ -0,0 +1,150 @@
+package transport
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iamnivekx/mpc-go/pkg/types"
+)
+
+// newPipedSessions returns a pair of Sessions wired together over an
+// in-memory net.Pipe, having already completed the handshake.
+func newPipedSessions(t *testing.T) (client, server *Session) {
+	t.Helper()
+	clientConn, serverConn := net.Pipe()
+
+	clientIdentity := newTestIdentity(t)
+	serverIdentity := newTestIdentity(t)
+
+	type result struct {
+		aead cipher.AEAD
+		err  error
+	}
+	clientCh := make(chan result, 1)
+	serverCh := make(chan result, 1)
+	go func() {
+		aead, err := handshake(clientConn, clientIdentity, serverIdentity.Public)
+		clientCh <- result{aead, err}
+	}()
+	go func() {
+		aead, err := handshake(serverConn, serverIdentity, clientIdentity.Public)
+		serverCh <- result{aead, err}
+	}()
+	cr := <-clientCh
+	sr := <-serverCh
+	require.NoError(t, cr.err)
+	require.NoError(t, sr.err)
+
+	noReconnect := func() (net.Conn, error) { return nil, assert.AnError }
+
+	client = &Session{
+		conn:     clientConn,
+		aead:     cr.aead,
+		identity: clientIdentity,
+		dial:     noReconnect,
+		inFlight: make(map[string]struct{}),
+	}
+	server = &Session{
+		conn:     serverConn,
+		aead:     sr.aead,
+		identity: serverIdentity,
+		dial:     noReconnect,
+		inFlight: make(map[string]struct{}),
+	}
+	return client, server
+}
+
+func TestSession_SendRecv_RoundTrip(t *testing.T) {
+	client, server := newPipedSessions(t)
+	defer client.Close()
+	defer server.Close()
+
+	msg := types.SigningMessage{WalletID: "wallet-1", TxID: "tx-1", Tx: []byte("tx-bytes")}
+
+	sendErrCh := make(chan error, 1)
+	go func() { sendErrCh <- client.Send(msg) }()
+
+	raw, err := server.recvSealed()
+	require.NoError(t, err)
+	require.NoError(t, <-sendErrCh)
+
+	var got types.SigningMessage
+	require.NoError(t, json.Unmarshal(raw, &got))
+	assert.Equal(t, msg, got)
+
+	_, stillInFlight := client.inFlight["tx-1"]
+	assert.True(t, stillInFlight, "TxID should be tracked until a matching response is received")
+}
+
+// newNullAEAD returns a placeholder AEAD for a Session's initial conn,
+// which is expected to fail before any encrypted data actually needs
+// decrypting (reconnect replaces it with the real negotiated AEAD).
+func newNullAEAD(t *testing.T) cipher.AEAD {
+	t.Helper()
+	block, err := aes.NewCipher(make([]byte, 32))
+	require.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	return aead
+}
+
+func TestSession_Reconnect_ResendsAfterDrop(t *testing.T) {
+	clientIdentity := newTestIdentity(t)
+	serverIdentity := newTestIdentity(t)
+
+	received := make(chan []byte, 1)
+	dialCount := 0
+
+	dial := func() (net.Conn, error) {
+		dialCount++
+		clientConn, serverConn := net.Pipe()
+
+		go func() {
+			aead, err := handshake(serverConn, serverIdentity, clientIdentity.Public)
+			if err != nil {
+				return
+			}
+			server := &Session{conn: serverConn, aead: aead, inFlight: make(map[string]struct{})}
+			raw, err := server.recvSealed()
+			if err != nil {
+				return
+			}
+			received <- raw
+		}()
+
+		return clientConn, nil
+	}
+
+	deadConn, deadPeer := net.Pipe()
+	deadPeer.Close()
+	deadConn.Close()
+
+	client := &Session{
+		conn:     deadConn,
+		aead:     newNullAEAD(t),
+		identity: clientIdentity,
+		dial:     dial,
+		policy:   ReconnectPolicy{MaxAttempts: 3, Backoff: time.Millisecond},
+		inFlight: make(map[string]struct{}),
+	}
+	defer client.Close()
+
+	msg := types.SigningMessage{WalletID: "wallet-1", TxID: "tx-resumed"}
+	require.NoError(t, client.Send(msg))
+
+	var got types.SigningMessage
+	require.NoError(t, json.Unmarshal(<-received, &got))
+	assert.Equal(t, msg, got)
+
+	assert.Equal(t, 1, dialCount, "should reconnect exactly once after the initial send fails")
+
+	_, stillInFlight := client.inFlight["tx-resumed"]
+	assert.True(t, stillInFlight, "in-flight TxID must survive the reconnect so the ceremony can resume")
+}
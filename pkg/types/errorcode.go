@@ -0,0 +1,14 @@
+package types
+
+// ErrorCode classifies why a signing round failed, reported back to the
+// caller on SigningResponse/SigningResultErrorEvent alongside the
+// human-readable ErrorReason.
+type ErrorCode int
+
+const (
+	ErrorCodeNone ErrorCode = iota
+	ErrorCodeTimeout
+	ErrorCodeInvalidRequest
+	ErrorCodeSigningFailed
+	ErrorCodePeerUnavailable
+)
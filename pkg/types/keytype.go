@@ -0,0 +1,11 @@
+package types
+
+// KeyType identifies which signature scheme a SigningMessage/SigningResponse
+// pair is for, since the two MPC key types produce differently shaped
+// signatures (see the field comments on SigningResponse).
+type KeyType string
+
+const (
+	KeyTypeECDSA KeyType = "ecdsa" // secp256k1, e.g. Bitcoin/Ethereum
+	KeyTypeEDDSA KeyType = "eddsa" // Ed25519
+)